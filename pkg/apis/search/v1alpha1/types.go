@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+)
+
+// BackendStoreConfig represents the configuration of the backend store that
+// resource cache events are shipped to. Exactly one of OpenSearch or
+// Elasticsearch should be set.
+type BackendStoreConfig struct {
+	// OpenSearch holds the configuration for an OpenSearch backend store.
+	// +optional
+	OpenSearch *OpenSearchConfig `json:"openSearch,omitempty"`
+
+	// Elasticsearch holds the configuration for an Elasticsearch backend
+	// store.
+	// +optional
+	Elasticsearch *ElasticsearchConfig `json:"elasticsearch,omitempty"`
+}
+
+// BulkConfig tunes how a backend store batches write operations before
+// shipping them as a bulk request. It is shared by every backend driver.
+type BulkConfig struct {
+	// BulkSize is the maximum number of documents to buffer before a bulk
+	// request is flushed. Defaults to 1000.
+	// +optional
+	BulkSize int `json:"bulkSize,omitempty"`
+
+	// BulkFlushBytes is the maximum size, in bytes, to buffer before a bulk
+	// request is flushed, regardless of BulkSize. Defaults to 5MB.
+	// +optional
+	BulkFlushBytes int64 `json:"bulkFlushBytes,omitempty"`
+
+	// FlushInterval is the maximum amount of time a document may sit in the
+	// buffer before being flushed, even if BulkSize/BulkFlushBytes haven't
+	// been reached. Defaults to 5s.
+	// +optional
+	FlushInterval metav1.Duration `json:"flushInterval,omitempty"`
+
+	// MaxRetries is the number of times a failed bulk item is retried with
+	// exponential backoff before being dropped. Defaults to 3.
+	// +optional
+	MaxRetries int `json:"maxRetries,omitempty"`
+
+	// Workers is the number of concurrent bulk flush workers. Defaults to 1.
+	// +optional
+	Workers int `json:"workers,omitempty"`
+}
+
+// RetentionConfig tunes how long a tombstoned (soft-deleted) document is
+// kept before a periodic reaper deletes it from the index. It is shared by
+// every backend driver.
+type RetentionConfig struct {
+	// TombstoneRetention is how long a tombstoned document is kept before
+	// it's rolled off. Defaults to 72h.
+	// +optional
+	TombstoneRetention metav1.Duration `json:"tombstoneRetention,omitempty"`
+}
+
+// OpenSearchConfig holds the configuration for the OpenSearch backend store.
+type OpenSearchConfig struct {
+	// Addresses is a list of OpenSearch nodes to use.
+	Addresses []string `json:"addresses"`
+
+	// SecretRef is a reference to the secret that contains the credentials
+	// for the OpenSearch cluster. In addition to "username"/"password", the
+	// secret may carry "ca.crt" (a PEM CA bundle), "tls.crt"/"tls.key" (a
+	// client cert/key pair for mTLS), and "apiKey"/"serviceToken" as an
+	// alternative to username/password auth.
+	// +optional
+	SecretRef clusterv1alpha1.LocalSecretReference `json:"secretRef,omitempty"`
+
+	// InsecureSkipVerify disables TLS certificate verification. Only set
+	// this for development clusters.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+
+	// BulkConfig tunes the bulk writer used to ship events to this backend.
+	// +optional
+	BulkConfig `json:",inline"`
+
+	// RetentionConfig tunes how long tombstoned documents are kept.
+	// +optional
+	RetentionConfig `json:",inline"`
+}
+
+// ElasticsearchConfig holds the configuration for an Elasticsearch backend
+// store, driven by github.com/elastic/go-elasticsearch/v8.
+type ElasticsearchConfig struct {
+	// Addresses is a list of Elasticsearch nodes to use.
+	Addresses []string `json:"addresses"`
+
+	// SecretRef is a reference to the secret that contains the credentials
+	// for the Elasticsearch cluster. In addition to "username"/"password",
+	// the secret may carry "apiKey" as an alternative to username/password
+	// auth via Elasticsearch's API key auth.
+	// +optional
+	SecretRef clusterv1alpha1.LocalSecretReference `json:"secretRef,omitempty"`
+
+	// BulkConfig tunes the bulk writer used to ship events to this backend.
+	// +optional
+	BulkConfig `json:",inline"`
+
+	// RetentionConfig tunes how long tombstoned documents are kept.
+	// +optional
+	RetentionConfig `json:",inline"`
+}
@@ -0,0 +1,19 @@
+package backendstore
+
+import "net/http"
+
+// headerTransport wraps a base http.RoundTripper and sets a single header
+// (typically Authorization) on every outgoing request. It's used to carry
+// API-key/service-token credentials that the opensearch-go client has no
+// first-class field for.
+type headerTransport struct {
+	base   http.RoundTripper
+	header string
+	value  string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(t.header, t.value)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,241 @@
+package backendstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+)
+
+const (
+	defaultBulkSize       = 1000
+	defaultBulkFlushBytes = 5 * 1024 * 1024
+	defaultFlushInterval  = 5 * time.Second
+	defaultMaxRetries     = 3
+	defaultWorkers        = 1
+)
+
+// bulkTransport is the thin seam a backend driver implements so its client
+// (opensearch-go, go-elasticsearch, ...) can be driven by the shared
+// bulkWriter below.
+type bulkTransport interface {
+	Bulk(ctx context.Context, body []byte) (*SearchResponse, error)
+}
+
+// bulkAction is a single queued operation (either an index or a delete) that
+// is waiting to be shipped to the backend in a bulk request.
+type bulkAction struct {
+	meta    map[string]interface{}
+	body    []byte
+	retries int
+}
+
+// bulkWriter coalesces index/delete operations into bulk requests against a
+// bulkTransport, flushing on size/byte/time thresholds and retrying failed
+// items with exponential backoff. It is shared by every backend driver.
+type bulkWriter struct {
+	transport bulkTransport
+
+	bulkSize       int
+	bulkFlushBytes int64
+	flushInterval  time.Duration
+	maxRetries     int
+
+	mu           sync.Mutex
+	pending      []*bulkAction
+	pendingBytes int64
+
+	flushCh chan []*bulkAction
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+	retryWG sync.WaitGroup
+}
+
+// newBulkWriter creates a bulkWriter configured from cfg, falling back to
+// sane defaults for any knob that isn't set, and starts its flush workers.
+func newBulkWriter(transport bulkTransport, cfg v1alpha1.BulkConfig) *bulkWriter {
+	w := &bulkWriter{
+		transport:      transport,
+		bulkSize:       defaultBulkSize,
+		bulkFlushBytes: defaultBulkFlushBytes,
+		flushInterval:  defaultFlushInterval,
+		maxRetries:     defaultMaxRetries,
+		flushCh:        make(chan []*bulkAction),
+		stopCh:         make(chan struct{}),
+	}
+
+	if cfg.BulkSize > 0 {
+		w.bulkSize = cfg.BulkSize
+	}
+	if cfg.BulkFlushBytes > 0 {
+		w.bulkFlushBytes = cfg.BulkFlushBytes
+	}
+	if cfg.FlushInterval.Duration > 0 {
+		w.flushInterval = cfg.FlushInterval.Duration
+	}
+	if cfg.MaxRetries > 0 {
+		w.maxRetries = cfg.MaxRetries
+	}
+
+	workers := defaultWorkers
+	if cfg.Workers > 0 {
+		workers = cfg.Workers
+	}
+
+	for i := 0; i < workers; i++ {
+		w.wg.Add(1)
+		go w.runWorker()
+	}
+
+	go w.runTicker()
+
+	return w
+}
+
+// add enqueues an action and flushes immediately if a threshold is crossed.
+func (w *bulkWriter) add(action *bulkAction) {
+	w.mu.Lock()
+	w.pending = append(w.pending, action)
+	w.pendingBytes += int64(len(action.body))
+	flush := len(w.pending) >= w.bulkSize || w.pendingBytes >= w.bulkFlushBytes
+	var batch []*bulkAction
+	if flush {
+		batch, w.pending, w.pendingBytes = w.pending, nil, 0
+	}
+	w.mu.Unlock()
+
+	if batch != nil {
+		w.flushCh <- batch
+	}
+}
+
+func (w *bulkWriter) runTicker() {
+	ticker := time.NewTicker(w.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.flush()
+		case <-w.stopCh:
+			return
+		}
+	}
+}
+
+// flush drains the current pending buffer and ships it to a worker.
+func (w *bulkWriter) flush() {
+	w.mu.Lock()
+	batch := w.pending
+	w.pending, w.pendingBytes = nil, 0
+	w.mu.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+	w.flushCh <- batch
+}
+
+func (w *bulkWriter) runWorker() {
+	defer w.wg.Done()
+	for batch := range w.flushCh {
+		w.send(batch)
+	}
+}
+
+// send issues a bulk request for the batch and re-queues any items that
+// failed with an exponential backoff based on their retry count.
+func (w *bulkWriter) send(batch []*bulkAction) {
+	var buf bytes.Buffer
+	for _, action := range batch {
+		meta, _ := json.Marshal(action.meta)
+		buf.Write(meta)
+		buf.WriteByte('\n')
+		if action.body != nil {
+			buf.Write(action.body)
+			buf.WriteByte('\n')
+		}
+	}
+
+	resp, err := w.transport.Bulk(context.Background(), buf.Bytes())
+	if err != nil {
+		klog.Errorf("bulk request failed: %v", err)
+		w.retry(batch)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		klog.Errorf("bulk request returned status %d", resp.StatusCode)
+		w.retry(batch)
+		return
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Status int         `json:"status"`
+			Error  interface{} `json:"error,omitempty"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		klog.Errorf("cannot decode bulk response: %v", err)
+		return
+	}
+
+	if !result.Errors {
+		return
+	}
+
+	var failed []*bulkAction
+	for i, item := range result.Items {
+		for _, info := range item {
+			if info.Error != nil && i < len(batch) {
+				failed = append(failed, batch[i])
+			}
+		}
+	}
+	w.retry(failed)
+}
+
+// retry re-enqueues failed items after an exponential backoff, dropping
+// items that have exceeded maxRetries. Each retry is tracked in retryWG so
+// close() can wait for in-flight backoffs instead of racing them. A retry
+// still waiting out its backoff when stopCh closes delivers its action
+// immediately instead of waiting out the rest of d, but it always calls
+// w.add(a) either way — close() waits on retryWG before its final flush(),
+// so the action is either flushed by add's own threshold check or picked
+// up by that final flush, while flushCh is still open.
+func (w *bulkWriter) retry(batch []*bulkAction) {
+	for _, action := range batch {
+		if action.retries >= w.maxRetries {
+			klog.Errorf("dropping bulk item after %d retries", action.retries)
+			continue
+		}
+		action.retries++
+		backoff := time.Duration(1<<uint(action.retries)) * 100 * time.Millisecond
+		w.retryWG.Add(1)
+		go func(a *bulkAction, d time.Duration) {
+			defer w.retryWG.Done()
+			select {
+			case <-time.After(d):
+			case <-w.stopCh:
+			}
+			w.add(a)
+		}(action, backoff)
+	}
+}
+
+// close stops the flush workers, blocking until all in-flight batches and
+// pending retries have been drained so shutdowns don't lose events.
+func (w *bulkWriter) close() {
+	close(w.stopCh)
+	w.retryWG.Wait()
+	w.flush()
+	close(w.flushCh)
+	w.wg.Wait()
+}
@@ -0,0 +1,70 @@
+package backendstore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+)
+
+// failOnceTransport fails exactly its first Bulk call and succeeds on every
+// call after that, recording each outcome so tests can assert a retry
+// actually landed.
+type failOnceTransport struct {
+	mu      sync.Mutex
+	calls   int
+	failed  bool
+	calledC chan struct{}
+}
+
+func (t *failOnceTransport) Bulk(_ context.Context, _ []byte) (*SearchResponse, error) {
+	t.mu.Lock()
+	t.calls++
+	failThisCall := !t.failed
+	t.failed = true
+	t.mu.Unlock()
+
+	t.calledC <- struct{}{}
+
+	if failThisCall {
+		return &SearchResponse{StatusCode: 500, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}
+	return &SearchResponse{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{"errors":false}`))}, nil
+}
+
+func (t *failOnceTransport) callCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.calls
+}
+
+// TestBulkWriterCloseDrainsInFlightRetry exercises the close()/retry() race:
+// an item still waiting out its backoff when close() runs must still be
+// sent, not silently dropped.
+func TestBulkWriterCloseDrainsInFlightRetry(t *testing.T) {
+	transport := &failOnceTransport{calledC: make(chan struct{}, 2)}
+	w := newBulkWriter(transport, v1alpha1.BulkConfig{BulkSize: 1, Workers: 1})
+
+	w.add(&bulkAction{meta: map[string]interface{}{"index": map[string]interface{}{}}})
+
+	select {
+	case <-transport.calledC:
+	case <-time.After(time.Second):
+		t.Fatal("bulk transport was never called")
+	}
+
+	// retry() runs synchronously in the worker right after the failing
+	// call returns, so give it a moment to schedule the backoff goroutine
+	// before racing it with close().
+	time.Sleep(20 * time.Millisecond)
+
+	w.close()
+
+	if got := transport.callCount(); got != 2 {
+		t.Fatalf("got %d bulk calls, want 2 (initial failure + retried delivery)", got)
+	}
+}
@@ -0,0 +1,55 @@
+package backendstore
+
+import (
+	"encoding/json"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+)
+
+// buildDoc stamps us with the cache-source annotation for cluster and
+// marshals it into the document shape every backend driver indexes:
+// apiVersion/kind/a metadata subset, plus every other top-level field of
+// the source object (spec/status for most kinds, but also flat-shaped
+// kinds like Event, whose involvedObject/reason/lastTimestamp live
+// directly on the object root). Shared by OpenSearch.upsert and
+// Elasticsearch.upsert so the two drivers can't drift on doc shape.
+func buildDoc(cluster string, us *unstructured.Unstructured) (*unstructured.Unstructured, []byte, error) {
+	us = us.DeepCopy()
+	annotations := us.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[clusterv1alpha1.CacheSourceAnnotationKey] = cluster
+	us.SetAnnotations(annotations)
+
+	doc := map[string]interface{}{
+		"apiVersion": us.GetAPIVersion(),
+		"kind":       us.GetKind(),
+		"metadata": map[string]interface{}{
+			"name":              us.GetName(),
+			"namespace":         us.GetNamespace(),
+			"creationTimestamp": us.GetCreationTimestamp().Format(time.RFC3339),
+			"labels":            us.GetLabels(),
+			"annotations":       us.GetAnnotations(),
+			"deletionTimestamp": us.GetDeletionTimestamp(),
+		},
+	}
+
+	for field, value := range us.Object {
+		switch field {
+		case "apiVersion", "kind", "metadata":
+			continue
+		default:
+			doc[field] = value
+		}
+	}
+
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return us, nil, err
+	}
+	return us, body, nil
+}
@@ -0,0 +1,51 @@
+package backendstore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+)
+
+func TestBuildDocPreservesFlatTopLevelFields(t *testing.T) {
+	us := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Event",
+		"metadata": map[string]interface{}{
+			"name": "my-event",
+		},
+		"involvedObject": map[string]interface{}{"kind": "Pod", "name": "my-pod"},
+		"reason":         "Scheduled",
+	}}
+
+	_, body, err := buildDoc("member-1", us)
+	if err != nil {
+		t.Fatalf("buildDoc: %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("cannot decode doc: %v", err)
+	}
+
+	if doc["reason"] != "Scheduled" {
+		t.Errorf("reason = %v, want %q", doc["reason"], "Scheduled")
+	}
+	if _, ok := doc["involvedObject"]; !ok {
+		t.Errorf("involvedObject missing from built doc: %v", doc)
+	}
+
+	metadata, ok := doc["metadata"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("metadata = %v, not an object", doc["metadata"])
+	}
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("metadata.annotations = %v, not an object", metadata["annotations"])
+	}
+	if annotations[clusterv1alpha1.CacheSourceAnnotationKey] != "member-1" {
+		t.Errorf("cache-source annotation = %v, want %q", annotations[clusterv1alpha1.CacheSourceAnnotationKey], "member-1")
+	}
+}
@@ -0,0 +1,261 @@
+package backendstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	elasticsearch "github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+)
+
+// Elasticsearch implements BackendStore on top of Elasticsearch, as an
+// alternative driver to OpenSearch. It shares the index-name/mapping
+// bootstrapping (indexManager) and bulk-writer (bulkWriter) with OpenSearch,
+// so switching drivers doesn't change any informer plumbing.
+type Elasticsearch struct {
+	cluster string
+	client  *elasticsearch.Client
+	indexes *indexManager
+	bulk    *bulkWriter
+	reaper  *tombstoneReaper
+}
+
+// NewElasticsearch returns a new Elasticsearch backend store.
+func NewElasticsearch(cluster string, cfg *v1alpha1.BackendStoreConfig) (*Elasticsearch, error) {
+	klog.Infof("create elasticsearch backend store: %s", cluster)
+	es := &Elasticsearch{cluster: cluster}
+
+	if err := es.initClient(cfg); err != nil {
+		return nil, fmt.Errorf("cannot init client: %v", err)
+	}
+
+	var bulkCfg v1alpha1.BulkConfig
+	var retention time.Duration
+	if cfg != nil && cfg.Elasticsearch != nil {
+		bulkCfg = cfg.Elasticsearch.BulkConfig
+		retention = cfg.Elasticsearch.TombstoneRetention.Duration
+	}
+	es.indexes = newIndexManager(esIndexCreator{es.client})
+	es.bulk = newBulkWriter(esBulkTransport{es.client}, bulkCfg)
+	es.reaper = newTombstoneReaper(esReaper{es.client}, defaultPrefix+"-*", retention)
+
+	return es, nil
+}
+
+// ResourceEventHandlerFuncs implements cache.ResourceEventHandler
+func (es *Elasticsearch) ResourceEventHandlerFuncs() cache.ResourceEventHandler {
+	return &cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			es.upsert(obj)
+		},
+		UpdateFunc: func(oldObj, curObj interface{}) {
+			es.upsert(curObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			es.delete(obj)
+		},
+	}
+}
+
+// Close the client, draining any buffered bulk actions and stopping the
+// tombstone reaper first so shutdowns don't lose events.
+func (es *Elasticsearch) Close() {
+	es.bulk.close()
+	es.reaper.close()
+}
+
+// delete tombstones the document instead of removing it outright; see
+// OpenSearch.delete for the rationale.
+func (es *Elasticsearch) delete(obj interface{}) {
+	us, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		klog.Errorf("unexpected type %T", obj)
+		return
+	}
+
+	indexName, err := es.indexName(us)
+	if err != nil {
+		klog.Errorf("cannot get index name: %v", err)
+		return
+	}
+
+	body, err := json.Marshal(tombstoneDoc())
+	if err != nil {
+		klog.Errorf("cannot marshal tombstone doc: %v", err)
+		return
+	}
+
+	es.bulk.add(&bulkAction{
+		meta: map[string]interface{}{
+			"update": map[string]interface{}{
+				"_index": indexName,
+				"_id":    string(us.GetUID()),
+			},
+		},
+		body: body,
+	})
+}
+
+func (es *Elasticsearch) upsert(obj interface{}) {
+	us, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		klog.Errorf("unexpected type %T", obj)
+		return
+	}
+
+	us, body, err := buildDoc(es.cluster, us)
+	if err != nil {
+		klog.Errorf("cannot marshal to json: %v", err)
+		return
+	}
+
+	indexName, err := es.indexName(us)
+	if err != nil {
+		klog.Errorf("cannot get index name: %v", err)
+		return
+	}
+
+	es.bulk.add(&bulkAction{
+		meta: map[string]interface{}{
+			"index": map[string]interface{}{
+				"_index": indexName,
+				"_id":    string(us.GetUID()),
+			},
+		},
+		body: body,
+	})
+}
+
+// indexName returns the index a resource of us's GVK belongs in, creating
+// it with a GVK-tailored mapping the first time it's seen.
+func (es *Elasticsearch) indexName(us *unstructured.Unstructured) (string, error) {
+	name := fmt.Sprintf("%s-%s", defaultPrefix, strings.ToLower(us.GetKind()))
+	if err := es.indexes.ensureIndex(context.Background(), us.GroupVersionKind(), name); err != nil {
+		return name, err
+	}
+	return name, nil
+}
+
+// Search runs a raw Elasticsearch DSL query against the given indices and
+// returns the response for the caller to decode.
+func (es *Elasticsearch) Search(ctx context.Context, indices []string, body []byte) (*SearchResponse, error) {
+	resp, err := esapi.SearchRequest{
+		Index: indices,
+		Body:  bytes.NewReader(body),
+	}.Do(ctx, es.client)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchResponse{StatusCode: resp.StatusCode, Body: resp.Body}, nil
+}
+
+// Get fetches a single document by index/id.
+func (es *Elasticsearch) Get(ctx context.Context, index, id string) (*SearchResponse, error) {
+	resp, err := esapi.GetRequest{Index: index, DocumentID: id}.Do(ctx, es.client)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchResponse{StatusCode: resp.StatusCode, Body: resp.Body}, nil
+}
+
+func (es *Elasticsearch) initClient(bsc *v1alpha1.BackendStoreConfig) error {
+	if bsc == nil || bsc.Elasticsearch == nil {
+		return errors.New("elasticsearch config is nil")
+	}
+
+	if len(bsc.Elasticsearch.Addresses) == 0 {
+		return errors.New("not found elasticsearch address")
+	}
+
+	cfg := elasticsearch.Config{Addresses: bsc.Elasticsearch.Addresses}
+
+	secretRef := bsc.Elasticsearch.SecretRef
+	if secretRef.Namespace == "" || secretRef.Name == "" {
+		klog.Warningf("not found secret for elasticsearch, try to without auth")
+	} else {
+		secret, err := k8sClient.CoreV1().Secrets(secretRef.Namespace).Get(context.TODO(), secretRef.Name, metav1.GetOptions{})
+		if err != nil {
+			klog.Warningf("cannot get secret %s/%s: %v, try to without auth", secretRef.Namespace, secretRef.Name, err)
+		} else if apiKey := string(secret.Data["apiKey"]); apiKey != "" {
+			cfg.APIKey = apiKey
+		} else {
+			cfg.Username = string(secret.Data["username"])
+			cfg.Password = string(secret.Data["password"])
+		}
+	}
+
+	client, err := elasticsearch.NewClient(cfg)
+	if err != nil {
+		return fmt.Errorf("cannot create elasticsearch client: %v", err)
+	}
+
+	info, err := client.Info()
+	if err != nil {
+		return fmt.Errorf("cannot get elasticsearch info: %v", err)
+	}
+
+	klog.V(4).Infof("elasticsearch client: %v", info)
+	es.client = client
+	return nil
+}
+
+// esBulkTransport adapts *elasticsearch.Client to bulkTransport.
+type esBulkTransport struct {
+	client *elasticsearch.Client
+}
+
+func (t esBulkTransport) Bulk(ctx context.Context, body []byte) (*SearchResponse, error) {
+	resp, err := esapi.BulkRequest{Body: bytes.NewReader(body)}.Do(ctx, t.client)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchResponse{StatusCode: resp.StatusCode, Body: resp.Body}, nil
+}
+
+// esIndexCreator adapts *elasticsearch.Client to indexCreator.
+type esIndexCreator struct {
+	client *elasticsearch.Client
+}
+
+func (c esIndexCreator) createIndex(ctx context.Context, name, body string) error {
+	resp, err := esapi.IndicesCreateRequest{Index: name, Body: bytes.NewReader([]byte(body))}.Do(ctx, c.client)
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			return nil
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() && !strings.Contains(resp.String(), "resource_already_exists_exception") {
+		return fmt.Errorf("%s", resp.String())
+	}
+	return nil
+}
+
+// esReaper adapts *elasticsearch.Client to deleteByQueryer.
+type esReaper struct {
+	client *elasticsearch.Client
+}
+
+func (r esReaper) deleteByQuery(ctx context.Context, indexPattern string, body []byte) error {
+	resp, err := esapi.DeleteByQueryRequest{Index: []string{indexPattern}, Body: bytes.NewReader(body)}.Do(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		return fmt.Errorf("%s", resp.String())
+	}
+	return nil
+}
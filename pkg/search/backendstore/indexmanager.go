@@ -0,0 +1,57 @@
+package backendstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// indexCreator is the thin seam a backend driver implements so the
+// index-bootstrapping logic below can be shared across drivers.
+type indexCreator interface {
+	// createIndex creates name with the given mapping body. It must treat
+	// "index already exists" as success.
+	createIndex(ctx context.Context, name, body string) error
+}
+
+// indexManager tracks which indices have already been created and
+// bootstraps new ones with a GVK-tailored mapping (see mappingFor) the
+// first time they're seen. It is shared by every backend driver so the
+// index-name/mapping logic doesn't need to be duplicated per driver.
+type indexManager struct {
+	creator indexCreator
+
+	mu      sync.Mutex
+	indices map[string]struct{}
+}
+
+func newIndexManager(creator indexCreator) *indexManager {
+	return &indexManager{creator: creator, indices: make(map[string]struct{})}
+}
+
+// ensureIndex creates name if it hasn't been seen before, using the mapping
+// registered for gvk.
+func (im *indexManager) ensureIndex(ctx context.Context, gvk schema.GroupVersionKind, name string) error {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	if _, ok := im.indices[name]; ok {
+		return nil
+	}
+
+	klog.Infof("try to create index: %s", name)
+	body, err := mappingFor(gvk)
+	if err != nil {
+		return fmt.Errorf("cannot build mapping: %v", err)
+	}
+
+	if err := im.creator.createIndex(ctx, name, body); err != nil {
+		return fmt.Errorf("cannot create index: %v", err)
+	}
+
+	im.indices[name] = struct{}{}
+	return nil
+}
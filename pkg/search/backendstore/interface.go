@@ -0,0 +1,54 @@
+package backendstore
+
+import (
+	"context"
+	"io"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+)
+
+// BackendStore is implemented by every resource cache backend driver (e.g.
+// OpenSearch, Elasticsearch). It both consumes informer events, via
+// ResourceEventHandlerFuncs, and serves reads back out, via Search/Get, so
+// that pkg/search/query can run against whichever driver is configured.
+type BackendStore interface {
+	// ResourceEventHandlerFuncs returns the handler a member cluster
+	// informer should register to ship add/update/delete events to this
+	// store.
+	ResourceEventHandlerFuncs() cache.ResourceEventHandler
+
+	// Search runs a raw query DSL body against indices and returns the
+	// driver's response for the caller to decode.
+	Search(ctx context.Context, indices []string, body []byte) (*SearchResponse, error)
+
+	// Get fetches a single document by index/id.
+	Get(ctx context.Context, index, id string) (*SearchResponse, error)
+
+	// Close stops the store, draining any buffered writes first.
+	Close()
+}
+
+// SearchResponse is the transport-agnostic shape both backend drivers
+// normalize their client's response into.
+type SearchResponse struct {
+	StatusCode int
+	Body       io.ReadCloser
+}
+
+// IsError reports whether the response represents a request error.
+func (r *SearchResponse) IsError() bool {
+	return r.StatusCode > 299
+}
+
+// NewBackendStore builds the BackendStore driver selected by cfg. Exactly
+// one of cfg.OpenSearch or cfg.Elasticsearch is expected to be set.
+func NewBackendStore(cluster string, cfg *v1alpha1.BackendStoreConfig) (BackendStore, error) {
+	switch {
+	case cfg != nil && cfg.Elasticsearch != nil:
+		return NewElasticsearch(cluster, cfg)
+	default:
+		return NewOpenSearch(cluster, cfg)
+	}
+}
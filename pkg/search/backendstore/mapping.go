@@ -0,0 +1,133 @@
+package backendstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	mappingRegistryMu sync.RWMutex
+	mappingRegistry   = map[schema.GroupVersionKind]string{}
+)
+
+func init() {
+	mustRegisterMapping(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"replicas": map[string]interface{}{"type": "integer"},
+			},
+		},
+	})
+	mustRegisterMapping(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, map[string]interface{}{
+		"status": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"podIP":  map[string]interface{}{"type": "ip"},
+				"podIPs": map[string]interface{}{"type": "flattened"},
+			},
+		},
+	})
+	mustRegisterMapping(schema.GroupVersionKind{Version: "v1", Kind: "Event"}, map[string]interface{}{
+		"involvedObject": map[string]interface{}{"type": "flattened"},
+		"reason":         keywordField(),
+		"lastTimestamp":  map[string]interface{}{"type": "date"},
+	})
+}
+
+// RegisterMapping registers a custom OpenSearch index mapping body for gvk,
+// replacing whatever mapping (built-in or previously registered) applied to
+// it. This lets third parties tailor the schema for their own CRDs without
+// forking backendstore.
+func RegisterMapping(gvk schema.GroupVersionKind, body string) {
+	mappingRegistryMu.Lock()
+	defer mappingRegistryMu.Unlock()
+	mappingRegistry[gvk] = body
+}
+
+// mustRegisterMapping builds a mapping from overrides and registers it,
+// panicking on the built-in mappings defined above since a marshal failure
+// there is a programming error, not a runtime condition.
+func mustRegisterMapping(gvk schema.GroupVersionKind, overrides map[string]interface{}) {
+	body, err := buildMapping(overrides)
+	if err != nil {
+		panic(fmt.Sprintf("cannot build built-in mapping for %s: %v", gvk, err))
+	}
+	RegisterMapping(gvk, body)
+}
+
+// mappingFor returns the mapping body to use when creating the index for
+// gvk, falling back to the generic mapping if nothing more specific has
+// been registered for it.
+func mappingFor(gvk schema.GroupVersionKind) (string, error) {
+	mappingRegistryMu.RLock()
+	body, ok := mappingRegistry[gvk]
+	mappingRegistryMu.RUnlock()
+	if ok {
+		return body, nil
+	}
+	return buildMapping(nil)
+}
+
+// keywordField is a text field with a .keyword sub-field for exact-match
+// term queries and sorting, matching how name/namespace were already
+// mapped.
+func keywordField() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "text",
+		"fields": map[string]interface{}{
+			"keyword": map[string]interface{}{"type": "keyword", "ignore_above": 256},
+		},
+	}
+}
+
+// buildMapping assembles the index settings/mappings body shared by every
+// GVK, then applies overrides on top of it (e.g. typed spec/status
+// sub-fields for well-known kinds). overrides may be nil for the generic,
+// fully-flattened mapping.
+func buildMapping(overrides map[string]interface{}) (string, error) {
+	properties := map[string]interface{}{
+		"apiVersion": map[string]interface{}{"type": "keyword"},
+		"kind":       map[string]interface{}{"type": "keyword"},
+		"metadata": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"annotations":       map[string]interface{}{"type": "flattened"},
+				"creationTimestamp": map[string]interface{}{"type": "date"},
+				"deletionTimestamp": map[string]interface{}{"type": "date"},
+				"labels":            map[string]interface{}{"type": "flattened"},
+				"name":              keywordField(),
+				"namespace":         keywordField(),
+				"ownerReferences":   map[string]interface{}{"type": "flattened"},
+				"resourceVersion":   map[string]interface{}{"type": "keyword"},
+			},
+		},
+		"spec":   map[string]interface{}{"type": "flattened"},
+		"status": map[string]interface{}{"type": "flattened"},
+
+		// Soft-delete bookkeeping, set by OpenSearch/Elasticsearch.delete
+		// instead of removing the document outright.
+		"_karmada_deleted_at": map[string]interface{}{"type": "date"},
+		"_karmada_tombstone":  map[string]interface{}{"type": "boolean"},
+	}
+
+	for field, mapping := range overrides {
+		properties[field] = mapping
+	}
+
+	body := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"index": map[string]interface{}{
+				"number_of_shards":   1,
+				"number_of_replicas": 0,
+			},
+		},
+		"mappings": map[string]interface{}{"properties": properties},
+	}
+
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
@@ -2,118 +2,55 @@ package backendstore
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/opensearch-project/opensearch-go"
-	"github.com/opensearch-project/opensearch-go/opensearchapi"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
-	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	"github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
 )
 
 var defaultPrefix = "kubernetes"
 
-var mapping = `
-{
-	"settings": {
-		"index": {
-			"number_of_shards": 1,
-			"number_of_replicas": 0
-		}
-	},
-	"mappings": {
-		"properties": {
-			"apiVersion": {
-				"type": "text"
-			},
-			"kind": {
-				"type": "text"
-			},
-			"metadata": {
-				"properties": {
-					"annotations": {
-						"type": "flattened"
-					},
-					"creationTimestamp": {
-						"type": "text"
-					},
-					"deletionTimestamp": {
-						"type": "text"
-					},
-					"labels": {
-						"type": "flattened"
-					},
-					"name": {
-						"type": "text",
-						"fields": {
-							"keyword": {
-								"type": "keyword",
-								"ignore_above": 256
-							}
-						}
-					},
-					"namespace": {
-						"type": "text",
-						"fields": {
-							"keyword": {
-								"type": "keyword",
-								"ignore_above": 256
-							}
-						}
-					},
-					"ownerReferences": {
-						"type": "flattened"
-					},
-					"resourceVersion": {
-						"type": "text",
-						"fields": {
-							"keyword": {
-								"type": "keyword",
-								"ignore_above": 256
-							}
-						}
-					}
-				},
-				"spec": {
-					"type": "flattened"
-				},
-				"status": {
-					"type": "flattened"
-				}
-			}
-		}
-	}
-}
-`
-
-// OpenSearch implements backendstore.BackendStore
+// OpenSearch implements BackendStore on top of OpenSearch.
 type OpenSearch struct {
 	cluster string
 	client  *opensearch.Client
-	indices map[string]struct{}
-	l       sync.Mutex
+	indexes *indexManager
+	bulk    *bulkWriter
+	reaper  *tombstoneReaper
 }
 
 // NewOpenSearch returns a new OpenSearch
 func NewOpenSearch(cluster string, cfg *v1alpha1.BackendStoreConfig) (*OpenSearch, error) {
 	klog.Infof("create openserch backend store: %s", cluster)
-	os := &OpenSearch{
-		cluster: cluster,
-		indices: make(map[string]struct{})}
+	os := &OpenSearch{cluster: cluster}
 
 	if err := os.initClient(cfg); err != nil {
 		return nil, fmt.Errorf("cannot init client: %v", err)
 	}
 
+	var bulkCfg v1alpha1.BulkConfig
+	var retention time.Duration
+	if cfg != nil && cfg.OpenSearch != nil {
+		bulkCfg = cfg.OpenSearch.BulkConfig
+		retention = cfg.OpenSearch.TombstoneRetention.Duration
+	}
+	os.indexes = newIndexManager(osIndexCreator{os.client})
+	os.bulk = newBulkWriter(osBulkTransport{os.client}, bulkCfg)
+	os.reaper = newTombstoneReaper(osReaper{os.client}, defaultPrefix+"-*", retention)
+
 	return os, nil
 }
 
@@ -132,10 +69,17 @@ func (os *OpenSearch) ResourceEventHandlerFuncs() cache.ResourceEventHandler {
 	}
 }
 
-// Close the client
-func (os *OpenSearch) Close() {}
+// Close the client, draining any buffered bulk actions and stopping the
+// tombstone reaper first so shutdowns don't lose events.
+func (os *OpenSearch) Close() {
+	os.bulk.close()
+	os.reaper.close()
+}
 
-// TODO: bulk delete
+// delete tombstones the document instead of removing it outright, so the
+// audit trail of what existed in the member cluster survives the resource
+// being reaped there. A tombstoneReaper ages tombstones out of the index
+// after v1alpha1.RetentionConfig.TombstoneRetention.
 func (os *OpenSearch) delete(obj interface{}) {
 	us, ok := obj.(*unstructured.Unstructured)
 	if !ok {
@@ -149,20 +93,23 @@ func (os *OpenSearch) delete(obj interface{}) {
 		return
 	}
 
-	delete := opensearchapi.DeleteRequest{
-		Index:      indexName,
-		DocumentID: string(us.GetUID()),
-	}
-
-	resp, err := delete.Do(context.Background(), os.client)
+	body, err := json.Marshal(tombstoneDoc())
 	if err != nil {
-		klog.Errorf("cannot delete: %v", err)
+		klog.Errorf("cannot marshal tombstone doc: %v", err)
 		return
 	}
-	klog.V(4).Infof("delete response: %v", resp.String())
+
+	os.bulk.add(&bulkAction{
+		meta: map[string]interface{}{
+			"update": map[string]interface{}{
+				"_index": indexName,
+				"_id":    string(us.GetUID()),
+			},
+		},
+		body: body,
+	})
 }
 
-// TODO: bulk upsert
 func (os *OpenSearch) upsert(obj interface{}) {
 	us, ok := obj.(*unstructured.Unstructured)
 	if !ok {
@@ -170,34 +117,7 @@ func (os *OpenSearch) upsert(obj interface{}) {
 		return
 	}
 
-	us = us.DeepCopy()
-	annotations := us.GetAnnotations()
-	if annotations == nil {
-		annotations = make(map[string]string)
-	}
-
-	annotations[clusterv1alpha1.CacheSourceAnnotationKey] = os.cluster
-	us.SetAnnotations(annotations)
-
-	doc := map[string]interface{}{
-		"apiVersion": us.GetAPIVersion(),
-		"kind":       us.GetKind(),
-		"metadata": map[string]interface{}{
-			"name":              us.GetName(),
-			"namespace":         us.GetNamespace(),
-			"creationTimestamp": us.GetCreationTimestamp().Format(time.RFC3339),
-			"labels":            us.GetLabels(),
-			"annotations":       us.GetAnnotations(),
-			"deletionTimestamp": us.GetDeletionTimestamp(),
-		},
-	}
-
-	spec, _ := json.Marshal(us.Object["spec"])
-	status, _ := json.Marshal(us.Object["status"])
-	doc["spec"] = string(spec)
-	doc["status"] = string(status)
-
-	body, err := json.Marshal(doc)
+	us, body, err := buildDoc(os.cluster, us)
 	if err != nil {
 		klog.Errorf("cannot marshal to json: %v", err)
 		return
@@ -209,52 +129,24 @@ func (os *OpenSearch) upsert(obj interface{}) {
 		return
 	}
 
-	req := opensearchapi.IndexRequest{
-		Index:      indexName,
-		DocumentID: string(us.GetUID()),
-		Body:       strings.NewReader(string(body)),
-	}
-	resp, err := req.Do(context.Background(), os.client)
-	if err != nil {
-		klog.Errorf("cannot upsert: %v", err)
-		return
-	}
-	if resp.IsError() {
-		klog.Errorf("upsert error: %s", resp.String())
-		return
-	}
-	klog.V(4).Infof("upsert response: %s", resp.String())
+	os.bulk.add(&bulkAction{
+		meta: map[string]interface{}{
+			"index": map[string]interface{}{
+				"_index": indexName,
+				"_id":    string(us.GetUID()),
+			},
+		},
+		body: body,
+	})
 }
 
-// TODO: apply mapping
+// indexName returns the index a resource of us's GVK belongs in, creating
+// it with a GVK-tailored mapping the first time it's seen.
 func (os *OpenSearch) indexName(us *unstructured.Unstructured) (string, error) {
 	name := fmt.Sprintf("%s-%s", defaultPrefix, strings.ToLower(us.GetKind()))
-	os.l.Lock()
-	defer os.l.Unlock()
-
-	if _, ok := os.indices[name]; !ok {
-		return name, nil
-	}
-
-	klog.Infof("try to create index: %s", name)
-	res := opensearchapi.IndicesCreateRequest{Index: name, Body: strings.NewReader(mapping)}
-	resp, err := res.Do(context.Background(), os.client)
-	if err != nil {
-		if strings.Contains(err.Error(), "already exists") {
-			klog.V(4).Info("index already exists")
-			os.indices[name] = struct{}{}
-			return name, nil
-		}
-		return name, fmt.Errorf("cannot create index: %v", err)
+	if err := os.indexes.ensureIndex(context.Background(), us.GroupVersionKind(), name); err != nil {
+		return name, err
 	}
-	if resp.IsError() {
-		return name, fmt.Errorf("cannot create index: %v", resp.String())
-	}
-
-	klog.V(4).Infof("create index response: %s", resp.String())
-
-	os.indices[name] = struct{}{}
-
 	return name, nil
 }
 
@@ -262,31 +154,53 @@ func (os *OpenSearch) initClient(bsc *v1alpha1.BackendStoreConfig) error {
 	if bsc == nil || bsc.OpenSearch == nil {
 		return errors.New("opensearch config is nil")
 	}
+	osCfg := bsc.OpenSearch
 
-	if len(bsc.OpenSearch.Addresses) == 0 {
+	if len(osCfg.Addresses) == 0 {
 		return errors.New("not found opensearch address")
 	}
-	cfg := opensearch.Config{Addresses: bsc.OpenSearch.Addresses}
+	cfg := opensearch.Config{Addresses: osCfg.Addresses}
 
-	user, pwd := func(secretRef clusterv1alpha1.LocalSecretReference) (user, pwd string) {
-		if secretRef.Namespace == "" || secretRef.Name == "" {
-			klog.Warningf("not found secret for opensearch, try to without auth")
-			return
-		}
+	secretRef := osCfg.SecretRef
+	var secret *corev1.Secret
+	if secretRef.Namespace == "" || secretRef.Name == "" {
+		klog.Warningf("not found secret for opensearch, try to without auth")
+	} else if s, err := k8sClient.CoreV1().Secrets(secretRef.Namespace).Get(context.TODO(), secretRef.Name, metav1.GetOptions{}); err != nil {
+		klog.Warningf("cannot get secret %s/%s: %v, try to without auth", secretRef.Namespace, secretRef.Name, err)
+	} else {
+		secret = s
+	}
 
-		secret, err := k8sClient.CoreV1().Secrets(secretRef.Namespace).Get(context.TODO(), secretRef.Name, metav1.GetOptions{})
-		if err != nil {
-			klog.Warningf("cannot get secret %s/%s: %v, try to without auth", secret.Namespace, secret.Name, err)
-			return
-		}
+	tlsConfig := &tls.Config{InsecureSkipVerify: osCfg.InsecureSkipVerify} // nolint:gosec // explicit opt-in for dev clusters
+	var transport http.RoundTripper = &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig}
 
-		return string(secret.Data["username"]), string(secret.Data["password"])
-	}(bsc.OpenSearch.SecretRef)
+	if secret != nil {
+		if caCert := secret.Data["ca.crt"]; len(caCert) > 0 {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caCert) {
+				return fmt.Errorf("cannot parse ca.crt")
+			}
+			tlsConfig.RootCAs = pool
+		}
+		if clientCert, clientKey := secret.Data["tls.crt"], secret.Data["tls.key"]; len(clientCert) > 0 && len(clientKey) > 0 {
+			cert, err := tls.X509KeyPair(clientCert, clientKey)
+			if err != nil {
+				return fmt.Errorf("cannot load client cert/key: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
 
-	if user != "" {
-		cfg.Username = user
-		cfg.Password = pwd
+		switch {
+		case len(secret.Data["apiKey"]) > 0:
+			transport = &headerTransport{base: transport, header: "Authorization", value: "ApiKey " + string(secret.Data["apiKey"])}
+		case len(secret.Data["serviceToken"]) > 0:
+			transport = &headerTransport{base: transport, header: "Authorization", value: "Bearer " + string(secret.Data["serviceToken"])}
+		case len(secret.Data["username"]) > 0:
+			cfg.Username = string(secret.Data["username"])
+			cfg.Password = string(secret.Data["password"])
+		}
 	}
+	cfg.Transport = transport
 
 	client, err := opensearch.NewClient(cfg)
 	if err != nil {
@@ -0,0 +1,120 @@
+package backendstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// defaultTombstoneRetention is how long a tombstoned document is kept when
+// a driver's RetentionConfig doesn't set one.
+const defaultTombstoneRetention = 72 * time.Hour
+
+// defaultReapInterval is how often the tombstoneReaper below checks for
+// tombstoned documents that have aged past their retention.
+const defaultReapInterval = 1 * time.Hour
+
+// deleteByQueryer is the thin seam a backend driver implements so
+// tombstoneReaper can age out old tombstones without duplicating the
+// request-building logic per driver.
+type deleteByQueryer interface {
+	// deleteByQuery deletes every document in indexPattern matching body, an
+	// already-marshaled query request body.
+	deleteByQuery(ctx context.Context, indexPattern string, body []byte) error
+}
+
+// tombstoneReaper periodically deletes documents that were tombstoned (see
+// tombstoneDoc) more than retention ago. Unlike an ILM/ISM policy, this
+// targets individual documents rather than whole indices, so live
+// (non-tombstoned) data in the same index is never touched.
+type tombstoneReaper struct {
+	deleter      deleteByQueryer
+	indexPattern string
+	retention    time.Duration
+	interval     time.Duration
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// newTombstoneReaper creates a tombstoneReaper for indexPattern and starts
+// its periodic reap loop.
+func newTombstoneReaper(deleter deleteByQueryer, indexPattern string, retention time.Duration) *tombstoneReaper {
+	if retention <= 0 {
+		retention = defaultTombstoneRetention
+	}
+
+	r := &tombstoneReaper{
+		deleter:      deleter,
+		indexPattern: indexPattern,
+		retention:    retention,
+		interval:     defaultReapInterval,
+		stopCh:       make(chan struct{}),
+	}
+
+	r.wg.Add(1)
+	go r.run()
+
+	return r
+}
+
+func (r *tombstoneReaper) run() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.reap()
+		case <-r.stopCh:
+			return
+		}
+	}
+}
+
+// reap issues a delete_by_query for every tombstoned document older than
+// retention, across every index matching indexPattern.
+func (r *tombstoneReaper) reap() {
+	body, err := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": []map[string]interface{}{
+					{"term": map[string]interface{}{"_karmada_tombstone": true}},
+					{"range": map[string]interface{}{"_karmada_deleted_at": map[string]interface{}{"lt": "now-" + retentionString(r.retention)}}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		klog.Errorf("cannot marshal tombstone reap query: %v", err)
+		return
+	}
+
+	if err := r.deleter.deleteByQuery(context.Background(), r.indexPattern, body); err != nil {
+		klog.Errorf("cannot reap tombstoned documents in %s: %v", r.indexPattern, err)
+	}
+}
+
+// close stops the reap loop and waits for any in-flight reap to finish.
+func (r *tombstoneReaper) close() {
+	close(r.stopCh)
+	r.wg.Wait()
+}
+
+// retentionString renders d the way OpenSearch/Elasticsearch date math
+// expects in a range query ("3d", "12h", ...).
+func retentionString(d time.Duration) string {
+	if d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	}
+	if d%time.Hour == 0 {
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	}
+	return fmt.Sprintf("%dm", int(d/time.Minute))
+}
@@ -0,0 +1,26 @@
+package backendstore
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetentionString(t *testing.T) {
+	cases := []struct {
+		name string
+		d    time.Duration
+		want string
+	}{
+		{name: "whole days", d: 72 * time.Hour, want: "3d"},
+		{name: "whole hours", d: 12 * time.Hour, want: "12h"},
+		{name: "sub-hour", d: 45 * time.Minute, want: "45m"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := retentionString(tc.d); got != tc.want {
+				t.Errorf("retentionString(%s) = %q, want %q", tc.d, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package backendstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/opensearch-project/opensearch-go"
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+// Search runs a raw OpenSearch DSL query against the given indices and
+// returns the response for the caller to decode.
+func (os *OpenSearch) Search(ctx context.Context, indices []string, body []byte) (*SearchResponse, error) {
+	req := opensearchapi.SearchRequest{
+		Index: indices,
+		Body:  bytes.NewReader(body),
+	}
+	resp, err := req.Do(ctx, os.client)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchResponse{StatusCode: resp.StatusCode, Body: resp.Body}, nil
+}
+
+// Get fetches a single document by index/id.
+func (os *OpenSearch) Get(ctx context.Context, index, id string) (*SearchResponse, error) {
+	req := opensearchapi.GetRequest{Index: index, DocumentID: id}
+	resp, err := req.Do(ctx, os.client)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchResponse{StatusCode: resp.StatusCode, Body: resp.Body}, nil
+}
+
+// osBulkTransport adapts *opensearch.Client to bulkTransport.
+type osBulkTransport struct {
+	client *opensearch.Client
+}
+
+func (t osBulkTransport) Bulk(ctx context.Context, body []byte) (*SearchResponse, error) {
+	req := opensearchapi.BulkRequest{Body: bytes.NewReader(body)}
+	resp, err := req.Do(ctx, t.client)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchResponse{StatusCode: resp.StatusCode, Body: resp.Body}, nil
+}
+
+// osIndexCreator adapts *opensearch.Client to indexCreator.
+type osIndexCreator struct {
+	client *opensearch.Client
+}
+
+func (c osIndexCreator) createIndex(ctx context.Context, name, body string) error {
+	res := opensearchapi.IndicesCreateRequest{Index: name, Body: bytes.NewReader([]byte(body))}
+	resp, err := res.Do(ctx, c.client)
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			return nil
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() && !strings.Contains(resp.String(), "resource_already_exists_exception") {
+		return fmt.Errorf("%s", resp.String())
+	}
+	return nil
+}
+
+// osReaper adapts *opensearch.Client to deleteByQueryer.
+type osReaper struct {
+	client *opensearch.Client
+}
+
+func (r osReaper) deleteByQuery(ctx context.Context, indexPattern string, body []byte) error {
+	req := opensearchapi.DeleteByQueryRequest{Index: []string{indexPattern}, Body: bytes.NewReader(body)}
+	resp, err := req.Do(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		return fmt.Errorf("%s", resp.String())
+	}
+	return nil
+}
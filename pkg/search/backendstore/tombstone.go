@@ -0,0 +1,20 @@
+package backendstore
+
+import "time"
+
+// tombstoneDoc returns the partial-update body OpenSearch/Elasticsearch
+// delete() sends in place of a hard delete: it marks the document deleted
+// without removing it, preserving the audit trail until the retention
+// policy ages it out.
+func tombstoneDoc() map[string]interface{} {
+	now := time.Now().UTC().Format(time.RFC3339)
+	return map[string]interface{}{
+		"doc": map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"deletionTimestamp": now,
+			},
+			"_karmada_deleted_at": now,
+			"_karmada_tombstone":  true,
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package query
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+)
+
+// Handler serves the aggregated multi-cluster search endpoint, translating
+// query parameters into a SearchQuery and returning the result as a
+// standard Kubernetes List response that client-go can decode.
+type Handler struct {
+	querier *Querier
+}
+
+// NewHandler returns an http.Handler backed by querier.
+func NewHandler(querier *Querier) *Handler {
+	return &Handler{querier: querier}
+}
+
+// ServeHTTP implements http.Handler.
+//
+// Supported query parameters: kind, namespace, labelSelector, fieldSelector,
+// q (free text), limit, continue, cluster (repeatable), includeDeleted.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+
+	searchQuery := &SearchQuery{
+		Clusters:       values["cluster"],
+		Namespace:      values.Get("namespace"),
+		Kind:           values.Get("kind"),
+		Query:          values.Get("q"),
+		Continue:       values.Get("continue"),
+		IncludeDeleted: values.Get("includeDeleted") == "true",
+	}
+
+	if s := values.Get("labelSelector"); s != "" {
+		selector, err := labels.Parse(s)
+		if err != nil {
+			http.Error(w, "invalid labelSelector: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		searchQuery.LabelSelector = selector
+	}
+
+	if s := values.Get("fieldSelector"); s != "" {
+		selector, err := fields.ParseSelector(s)
+		if err != nil {
+			http.Error(w, "invalid fieldSelector: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		searchQuery.FieldSelector = selector
+	}
+
+	if s := values.Get("limit"); s != "" {
+		limit, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid limit: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		searchQuery.Limit = limit
+	}
+
+	list, cont, err := h.querier.Search(r.Context(), searchQuery)
+	if err != nil {
+		klog.Errorf("search failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if cont != "" {
+		list.SetContinue(cont)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		klog.Errorf("cannot encode search response: %v", err)
+	}
+}
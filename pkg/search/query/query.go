@@ -0,0 +1,333 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/search/backendstore"
+)
+
+const defaultIndexPrefix = "kubernetes"
+
+// defaultLimit is used when a SearchQuery doesn't specify one.
+const defaultLimit = 100
+
+// Querier executes SearchQuery requests against a backendstore.BackendStore,
+// fanning out across all clusters whose resources were cached into the same
+// index.
+type Querier struct {
+	store backendstore.BackendStore
+}
+
+// NewQuerier returns a Querier backed by store.
+func NewQuerier(store backendstore.BackendStore) *Querier {
+	return &Querier{store: store}
+}
+
+// Search translates q into an OpenSearch DSL query, executes it, and
+// returns the matching documents as an UnstructuredList-compatible page.
+func (querier *Querier) Search(ctx context.Context, q *SearchQuery) (*unstructured.UnstructuredList, string, error) {
+	index, err := indexPattern(q.Kind)
+	if err != nil {
+		return nil, "", err
+	}
+
+	body, err := buildDSL(q)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot build query: %v", err)
+	}
+
+	resp, err := querier.store.Search(ctx, []string{index}, body)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot search: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return nil, "", fmt.Errorf("search error: status %d", resp.StatusCode)
+	}
+
+	var result searchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, "", fmt.Errorf("cannot decode search response: %v", err)
+	}
+
+	list := &unstructured.UnstructuredList{}
+	var lastSort []interface{}
+	for _, hit := range result.Hits.Hits {
+		obj, err := toUnstructured(hit.Source)
+		if err != nil {
+			klog.Errorf("cannot convert hit %s to unstructured: %v", hit.ID, err)
+			continue
+		}
+		list.Items = append(list.Items, *obj)
+		lastSort = hit.Sort
+	}
+
+	var cont string
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if int64(len(result.Hits.Hits)) == limit && lastSort != nil {
+		cont, err = encodeContinue(lastSort)
+		if err != nil {
+			return nil, "", fmt.Errorf("cannot encode continue token: %v", err)
+		}
+	}
+
+	return list, cont, nil
+}
+
+// indexPattern returns the index (or index pattern) a SearchQuery's Kind
+// maps to. Kind is required because documents for different GVKs are
+// routed to different indices by backendstore.OpenSearch.indexName.
+func indexPattern(kind string) (string, error) {
+	if kind == "" {
+		return "", fmt.Errorf("kind is required")
+	}
+	return fmt.Sprintf("%s-%s", defaultIndexPrefix, strings.ToLower(kind)), nil
+}
+
+// buildDSL translates a SearchQuery into an OpenSearch request body that
+// bool-filters on namespace/cluster/label/field selectors, full-text
+// matches the free-text query, and paginates via search_after.
+func buildDSL(q *SearchQuery) ([]byte, error) {
+	var filter []map[string]interface{}
+
+	if q.Namespace != "" {
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{"metadata.namespace.keyword": q.Namespace},
+		})
+	}
+
+	if q.APIVersion != "" {
+		filter = append(filter, termDSL("apiVersion", q.APIVersion))
+	}
+
+	if len(q.Clusters) > 0 {
+		clusterTerms := make([]interface{}, 0, len(q.Clusters))
+		for _, c := range q.Clusters {
+			clusterTerms = append(clusterTerms, c)
+		}
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{
+				fmt.Sprintf("metadata.annotations.%s", clusterv1alpha1.CacheSourceAnnotationKey): clusterTerms,
+			},
+		})
+	}
+
+	var mustNot []map[string]interface{}
+
+	if q.LabelSelector != nil && !q.LabelSelector.Empty() {
+		f, mn := labelSelectorDSL(q.LabelSelector)
+		filter = append(filter, f...)
+		mustNot = append(mustNot, mn...)
+	}
+
+	if q.FieldSelector != nil && !q.FieldSelector.Empty() {
+		f, mn := fieldSelectorDSL(q.FieldSelector)
+		filter = append(filter, f...)
+		mustNot = append(mustNot, mn...)
+	}
+
+	var must []map[string]interface{}
+	if q.Query != "" {
+		must = append(must, map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":  q.Query,
+				"fields": []string{"apiVersion", "kind", "metadata.name", "metadata.namespace", "spec", "status"},
+			},
+		})
+	}
+
+	if !q.IncludeDeleted {
+		mustNot = append(mustNot, map[string]interface{}{
+			"term": map[string]interface{}{"_karmada_tombstone": true},
+		})
+	}
+
+	boolQuery := map[string]interface{}{}
+	if len(filter) > 0 {
+		boolQuery["filter"] = filter
+	}
+	if len(must) > 0 {
+		boolQuery["must"] = must
+	}
+	if len(mustNot) > 0 {
+		boolQuery["must_not"] = mustNot
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	body := map[string]interface{}{
+		"size": limit,
+		"sort": []map[string]interface{}{
+			{"metadata.resourceVersion": "asc"},
+			{"_id": "asc"},
+		},
+	}
+	if len(boolQuery) > 0 {
+		body["query"] = map[string]interface{}{"bool": boolQuery}
+	}
+
+	if q.Continue != "" {
+		searchAfter, err := decodeContinue(q.Continue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid continue token: %v", err)
+		}
+		body["search_after"] = searchAfter
+	}
+
+	return json.Marshal(body)
+}
+
+// labelSelectorDSL translates every requirement of selector into filter
+// (must-match) and mustNot (must-not-match) DSL clauses, using
+// labels.Selector.Requirements() so set-based operators (in, notin, exists,
+// !=) are honored rather than just the "k=v" case. The gt/lt operators are
+// not translated; such requirements are left out of the query.
+func labelSelectorDSL(selector labels.Selector) (filter, mustNot []map[string]interface{}) {
+	for _, req := range selector.Requirements() {
+		path := fmt.Sprintf("metadata.labels.%s", req.Key())
+		values := req.Values().List()
+		switch req.Operator() {
+		case selection.Equals, selection.DoubleEquals:
+			if len(values) > 0 {
+				filter = append(filter, termDSL(path, values[0]))
+			}
+		case selection.In:
+			filter = append(filter, termsDSL(path, values))
+		case selection.NotEquals:
+			if len(values) > 0 {
+				mustNot = append(mustNot, termDSL(path, values[0]))
+			}
+		case selection.NotIn:
+			mustNot = append(mustNot, termsDSL(path, values))
+		case selection.Exists:
+			filter = append(filter, existsDSL(path))
+		case selection.DoesNotExist:
+			mustNot = append(mustNot, existsDSL(path))
+		}
+	}
+	return filter, mustNot
+}
+
+// keywordFields are the dotted field paths mapped as analyzed text with a
+// ".keyword" sub-field (see backendstore.keywordField); a term query against
+// the bare path matches tokens, not the literal value, so it must be
+// redirected to the sub-field instead.
+var keywordFields = map[string]bool{
+	"metadata.name":      true,
+	"metadata.namespace": true,
+}
+
+// fieldDSLPath returns the field path to use in a term query for field,
+// redirecting to its ".keyword" sub-field when field is analyzed text.
+func fieldDSLPath(field string) string {
+	if keywordFields[field] {
+		return field + ".keyword"
+	}
+	return field
+}
+
+// fieldSelectorDSL translates every requirement of selector into filter and
+// mustNot DSL clauses. fields.Selector only supports equality-based
+// operators, so Requirement.Operator is either selection.Equals/
+// DoubleEquals or selection.NotEquals.
+func fieldSelectorDSL(selector fields.Selector) (filter, mustNot []map[string]interface{}) {
+	for _, req := range selector.Requirements() {
+		path := fieldDSLPath(req.Field)
+		switch req.Operator {
+		case selection.Equals, selection.DoubleEquals:
+			filter = append(filter, termDSL(path, req.Value))
+		case selection.NotEquals:
+			mustNot = append(mustNot, termDSL(path, req.Value))
+		}
+	}
+	return filter, mustNot
+}
+
+func termDSL(field string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{"term": map[string]interface{}{field: value}}
+}
+
+func termsDSL(field string, values []string) map[string]interface{} {
+	vs := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		vs = append(vs, v)
+	}
+	return map[string]interface{}{"terms": map[string]interface{}{field: vs}}
+}
+
+func existsDSL(field string) map[string]interface{} {
+	return map[string]interface{}{"exists": map[string]interface{}{"field": field}}
+}
+
+func encodeContinue(sortValues []interface{}) (string, error) {
+	raw, err := json.Marshal(sortValues)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeContinue(token string) ([]interface{}, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	var sortValues []interface{}
+	if err := json.Unmarshal(raw, &sortValues); err != nil {
+		return nil, err
+	}
+	return sortValues, nil
+}
+
+// toUnstructured rebuilds an unstructured.Unstructured from the doc shape
+// written by a backend driver's upsert, which copies every top-level field
+// of the source object (not just spec/status, since flat-shaped kinds like
+// Event carry fields such as involvedObject directly on the object root).
+func toUnstructured(source map[string]interface{}) (*unstructured.Unstructured, error) {
+	obj := map[string]interface{}{
+		"apiVersion": source["apiVersion"],
+		"kind":       source["kind"],
+		"metadata":   source["metadata"],
+	}
+
+	for field, value := range source {
+		switch field {
+		case "apiVersion", "kind", "metadata", "_karmada_tombstone", "_karmada_deleted_at":
+			continue
+		default:
+			obj[field] = value
+		}
+	}
+
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+// searchResponse is the subset of an OpenSearch search response this
+// package cares about.
+type searchResponse struct {
+	Hits struct {
+		Hits []struct {
+			ID     string                 `json:"_id"`
+			Source map[string]interface{} `json:"_source"`
+			Sort   []interface{}          `json:"sort"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
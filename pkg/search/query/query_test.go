@@ -0,0 +1,163 @@
+package query
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestLabelSelectorDSL(t *testing.T) {
+	cases := []struct {
+		name        string
+		selector    string
+		wantFilter  []map[string]interface{}
+		wantMustNot []map[string]interface{}
+	}{
+		{
+			name:       "equals",
+			selector:   "environment=production",
+			wantFilter: []map[string]interface{}{termDSL("metadata.labels.environment", "production")},
+		},
+		{
+			name:       "in",
+			selector:   "environment in (production,qa)",
+			wantFilter: []map[string]interface{}{termsDSL("metadata.labels.environment", []string{"production", "qa"})},
+		},
+		{
+			name:        "notin",
+			selector:    "environment notin (production,qa)",
+			wantMustNot: []map[string]interface{}{termsDSL("metadata.labels.environment", []string{"production", "qa"})},
+		},
+		{
+			name:       "exists",
+			selector:   "environment",
+			wantFilter: []map[string]interface{}{existsDSL("metadata.labels.environment")},
+		},
+		{
+			name:        "does not exist",
+			selector:    "!environment",
+			wantMustNot: []map[string]interface{}{existsDSL("metadata.labels.environment")},
+		},
+		{
+			name:        "not equals",
+			selector:    "environment!=production",
+			wantMustNot: []map[string]interface{}{termDSL("metadata.labels.environment", "production")},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := labels.Parse(tc.selector)
+			if err != nil {
+				t.Fatalf("cannot parse selector %q: %v", tc.selector, err)
+			}
+
+			filter, mustNot := labelSelectorDSL(selector)
+			assertDSLEqual(t, "filter", filter, tc.wantFilter)
+			assertDSLEqual(t, "mustNot", mustNot, tc.wantMustNot)
+		})
+	}
+}
+
+func TestFieldSelectorDSL(t *testing.T) {
+	cases := []struct {
+		name        string
+		selector    string
+		wantFilter  []map[string]interface{}
+		wantMustNot []map[string]interface{}
+	}{
+		{
+			// metadata.name is mapped as analyzed text with a .keyword
+			// sub-field (backendstore.keywordField); a term query against
+			// the bare path would match tokens, not the literal name.
+			name:       "keyword field redirected to .keyword",
+			selector:   "metadata.name=my-app",
+			wantFilter: []map[string]interface{}{termDSL("metadata.name.keyword", "my-app")},
+		},
+		{
+			name:       "namespace redirected to .keyword",
+			selector:   "metadata.namespace=default",
+			wantFilter: []map[string]interface{}{termDSL("metadata.namespace.keyword", "default")},
+		},
+		{
+			name:        "not equals",
+			selector:    "status.phase!=Running",
+			wantMustNot: []map[string]interface{}{termDSL("status.phase", "Running")},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := fields.ParseSelector(tc.selector)
+			if err != nil {
+				t.Fatalf("cannot parse selector %q: %v", tc.selector, err)
+			}
+
+			filter, mustNot := fieldSelectorDSL(selector)
+			assertDSLEqual(t, "filter", filter, tc.wantFilter)
+			assertDSLEqual(t, "mustNot", mustNot, tc.wantMustNot)
+		})
+	}
+}
+
+func TestBuildDSLExcludesTombstonesByDefault(t *testing.T) {
+	body, err := buildDSL(&SearchQuery{Kind: "Pod"})
+	if err != nil {
+		t.Fatalf("buildDSL: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("cannot decode DSL: %v", err)
+	}
+
+	mustNot := decoded["query"].(map[string]interface{})["bool"].(map[string]interface{})["must_not"]
+	if mustNot == nil {
+		t.Fatalf("expected a must_not tombstone clause, got none in %s", body)
+	}
+}
+
+func TestBuildDSLIncludeDeletedDropsTombstoneClause(t *testing.T) {
+	body, err := buildDSL(&SearchQuery{Kind: "Pod", IncludeDeleted: true})
+	if err != nil {
+		t.Fatalf("buildDSL: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("cannot decode DSL: %v", err)
+	}
+
+	if query, ok := decoded["query"]; ok {
+		if mustNot := query.(map[string]interface{})["bool"].(map[string]interface{})["must_not"]; mustNot != nil {
+			t.Fatalf("expected no must_not clause with IncludeDeleted, got %v", mustNot)
+		}
+	}
+}
+
+// assertDSLEqual compares two DSL clause lists via their JSON encoding,
+// since map key order is insignificant but nil-vs-empty slices aren't.
+func assertDSLEqual(t *testing.T, label string, got, want []map[string]interface{}) {
+	t.Helper()
+
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("cannot marshal got %s: %v", label, err)
+	}
+	wantJSON, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("cannot marshal want %s: %v", label, err)
+	}
+
+	var gotVal, wantVal interface{}
+	_ = json.Unmarshal(gotJSON, &gotVal)
+	_ = json.Unmarshal(wantJSON, &wantVal)
+
+	gotNorm, _ := json.Marshal(gotVal)
+	wantNorm, _ := json.Marshal(wantVal)
+	if string(gotNorm) != string(wantNorm) {
+		t.Errorf("%s = %s, want %s", label, gotNorm, wantNorm)
+	}
+}
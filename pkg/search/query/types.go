@@ -0,0 +1,52 @@
+package query
+
+import (
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SearchQuery describes a multi-cluster search request against the
+// OpenSearch-backed resource catalog.
+type SearchQuery struct {
+	// Clusters restricts the search to resources cached from these member
+	// clusters. Matched against the clusterv1alpha1.CacheSourceAnnotationKey
+	// annotation. Empty means "all clusters".
+	Clusters []string
+
+	// Namespace restricts the search to a single namespace. Empty means
+	// "all namespaces".
+	Namespace string
+
+	// APIVersion and Kind select which GVK-backed index to query. Kind is
+	// required; APIVersion is optional and only used to disambiguate.
+	APIVersion string
+	Kind       string
+
+	LabelSelector labels.Selector
+	FieldSelector fields.Selector
+
+	// Query is a free-text query matched against the indexed document.
+	Query string
+
+	// Limit is the maximum number of results to return in one page.
+	Limit int64
+
+	// Continue is an opaque token returned by a previous call, used to
+	// resume a search_after-paginated query.
+	Continue string
+
+	// IncludeDeleted, when true, also returns tombstoned (soft-deleted)
+	// resources, useful for inspecting what existed in a member cluster at
+	// a past point in time. Defaults to excluding them.
+	IncludeDeleted bool
+}
+
+// SearchResult is a single page of a SearchQuery.
+type SearchResult struct {
+	// Items are the resources matched by this page, across all clusters.
+	Items []map[string]interface{}
+
+	// Continue is set when more results are available; pass it back on the
+	// next SearchQuery to fetch the next page.
+	Continue string
+}